@@ -377,6 +377,31 @@ func TestClusteringAssignedDurableNodeID(t *testing.T) {
 	}
 }
 
+// Ensure that delivery can't be paused in clustered mode since the
+// maintenance mode would be lost on leader election.
+func TestClusteringPauseDeliveryNotSupported(t *testing.T) {
+	cleanupDatastore(t)
+	defer cleanupDatastore(t)
+	cleanupRaftLog(t)
+	defer cleanupRaftLog(t)
+
+	// For this test, use a central NATS server.
+	ns := natsdTest.RunDefaultServer()
+	defer ns.Shutdown()
+
+	s1sOpts := getTestDefaultOptsForClustering("a", true)
+	s1 := runServerWithOpts(t, s1sOpts, nil)
+	defer s1.Shutdown()
+
+	leader := getLeader(t, 10*time.Second, s1)
+	if err := leader.PauseDelivery(); err != ErrPauseNotStandalone {
+		t.Fatalf("Expected error %v, got %v", ErrPauseNotStandalone, err)
+	}
+	if leader.IsDeliveryPaused() {
+		t.Fatal("Delivery should not be paused")
+	}
+}
+
 // Ensure clustering node ID is stored and recovered on server restart.
 func TestClusteringDurableNodeID(t *testing.T) {
 	cleanupDatastore(t)
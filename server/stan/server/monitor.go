@@ -36,27 +36,41 @@ const (
 	ClientsPath  = RootPath + "/clientsz"
 	ChannelsPath = RootPath + "/channelsz"
 
+	// Registered only if Options.MaintenanceMonitor is set.
+	MaintenancePath = RootPath + "/maintenancez"
+
 	defaultMonitorListLimit = 1024
 )
 
 // Serverz describes the NATS Streaming Server
 type Serverz struct {
-	ClusterID     string    `json:"cluster_id"`
-	ServerID      string    `json:"server_id"`
-	Version       string    `json:"version"`
-	GoVersion     string    `json:"go"`
-	State         string    `json:"state"`
-	Role          string    `json:"role,omitempty"`
-	Now           time.Time `json:"now"`
-	Start         time.Time `json:"start_time"`
-	Uptime        string    `json:"uptime"`
-	Clients       int       `json:"clients"`
-	Subscriptions int       `json:"subscriptions"`
-	Channels      int       `json:"channels"`
-	TotalMsgs     int       `json:"total_msgs"`
-	TotalBytes    uint64    `json:"total_bytes"`
-	OpenFDs       int       `json:"open_fds,omitempty"`
-	MaxFDs        int       `json:"max_fds,omitempty"`
+	ClusterID      string     `json:"cluster_id"`
+	ServerID       string     `json:"server_id"`
+	Version        string     `json:"version"`
+	GoVersion      string     `json:"go"`
+	State          string     `json:"state"`
+	Role           string     `json:"role,omitempty"`
+	Now            time.Time  `json:"now"`
+	Start          time.Time  `json:"start_time"`
+	Uptime         string     `json:"uptime"`
+	Clients        int        `json:"clients"`
+	Subscriptions  int        `json:"subscriptions"`
+	Channels       int        `json:"channels"`
+	TotalMsgs      int        `json:"total_msgs"`
+	TotalBytes     uint64     `json:"total_bytes"`
+	OpenFDs        int        `json:"open_fds,omitempty"`
+	MaxFDs         int        `json:"max_fds,omitempty"`
+	DeliveryPaused bool       `json:"delivery_paused,omitempty"`
+	PausedSince    *time.Time `json:"paused_since,omitempty"`
+}
+
+// Maintenancez describes the maintenance mode of the NATS Streaming Server
+type Maintenancez struct {
+	ClusterID      string     `json:"cluster_id"`
+	ServerID       string     `json:"server_id"`
+	Now            time.Time  `json:"now"`
+	DeliveryPaused bool       `json:"delivery_paused"`
+	PausedSince    *time.Time `json:"paused_since,omitempty"`
 }
 
 // Storez describes the NATS Streaming Store
@@ -155,6 +169,9 @@ func (s *StanServer) startMonitoring(nOpts *natsd.Options) error {
 	mux.HandleFunc(StorePath, s.handleStorez)
 	mux.HandleFunc(ClientsPath, s.handleClientsz)
 	mux.HandleFunc(ChannelsPath, s.handleChannelsz)
+	if s.opts.MaintenanceMonitor {
+		mux.HandleFunc(MaintenancePath, s.handleMaintenancez)
+	}
 
 	return nil
 }
@@ -233,9 +250,49 @@ func (s *StanServer) handleServerz(w http.ResponseWriter, r *http.Request) {
 		OpenFDs:       fds,
 		MaxFDs:        maxFDs,
 	}
+	if pausedAt, paused := s.deliveryPausedSince(); paused {
+		serverz.DeliveryPaused = true
+		serverz.PausedSince = &pausedAt
+	}
 	s.sendResponse(w, r, serverz)
 }
 
+// handleMaintenancez reports the maintenance mode on GET, and pauses or
+// resumes delivery on POST with "pause=true" or "pause=false".
+func (s *StanServer) handleMaintenancez(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPost:
+		pause, err := strconv.ParseBool(r.URL.Query().Get("pause"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Invalid value for pause: %q", r.URL.Query().Get("pause")), http.StatusBadRequest)
+			return
+		}
+		if pause {
+			if err := s.PauseDelivery(); err != nil {
+				http.Error(w, fmt.Sprintf("Error pausing delivery: %v", err), http.StatusConflict)
+				return
+			}
+		} else {
+			s.ResumeDelivery()
+		}
+	default:
+		w.Header().Set("Allow", http.MethodGet+", "+http.MethodPost)
+		http.Error(w, fmt.Sprintf("Method %s not allowed", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+	mz := &Maintenancez{
+		ClusterID: s.info.ClusterID,
+		ServerID:  s.serverID,
+		Now:       time.Now(),
+	}
+	if pausedAt, paused := s.deliveryPausedSince(); paused {
+		mz.DeliveryPaused = true
+		mz.PausedSince = &pausedAt
+	}
+	s.sendResponse(w, r, mz)
+}
+
 func myUptime(d time.Duration) string {
 	// Just use total seconds for uptime, and display days / years
 	tsecs := d / time.Second
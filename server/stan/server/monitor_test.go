@@ -255,8 +255,30 @@ func TestMonitorServerz(t *testing.T) {
 			t.Fatal("open_fds and max_fds should be omitempty")
 		}
 	}
+	if sz.DeliveryPaused || sz.PausedSince != nil ||
+		strings.Contains(string(body), "delivery_paused") || strings.Contains(string(body), "paused_since") {
+		t.Fatal("delivery_paused and paused_since should be omitempty")
+	}
 	resp.Body.Close()
 
+	if err := s.PauseDelivery(); err != nil {
+		t.Fatalf("Error pausing delivery: %v", err)
+	}
+	defer s.ResumeDelivery()
+	resp, body = getBody(t, ServerPath, expectedJSON)
+	sz = Serverz{}
+	if err := json.Unmarshal(body, &sz); err != nil {
+		resp.Body.Close()
+		t.Fatalf("Got an error unmarshalling the body: %v", err)
+	}
+	resp.Body.Close()
+	if !sz.DeliveryPaused {
+		t.Fatal("Expected delivery_paused to be true")
+	}
+	if sz.PausedSince == nil || sz.PausedSince.IsZero() {
+		t.Fatal("Expected paused_since to be set")
+	}
+
 	if err := sub.Unsubscribe(); err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
@@ -393,6 +415,100 @@ func TestMonitorUptime(t *testing.T) {
 	}
 }
 
+func monitorMaintenancez(t *testing.T, method, query string, expectedStatus int) *Maintenancez {
+	url := fmt.Sprintf("http://%s:%d%s%s", monitorHost, monitorPort, MaintenancePath, query)
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		stackFatalf(t, "Error creating request: %v", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		stackFatalf(t, "Expected no error: Got %v\n", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != expectedStatus {
+		stackFatalf(t, "Expected a %d response, got %d\n", expectedStatus, resp.StatusCode)
+	}
+	if expectedStatus != http.StatusOK {
+		return nil
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		stackFatalf(t, "Got an error reading the body: %v\n", err)
+	}
+	mz := &Maintenancez{}
+	if err := json.Unmarshal(body, mz); err != nil {
+		stackFatalf(t, "Got an error unmarshalling the body: %v", err)
+	}
+	return mz
+}
+
+func TestMonitorMaintenancez(t *testing.T) {
+	resetPreviousHTTPConnections()
+	s := runMonitorServer(t, GetDefaultOptions())
+	// Endpoint is not registered by default.
+	monitorExpectStatus(t, MaintenancePath, http.StatusNotFound)
+	s.Shutdown()
+
+	resetPreviousHTTPConnections()
+	opts := GetDefaultOptions()
+	opts.MaintenanceMonitor = true
+	s = runMonitorServer(t, opts)
+	defer s.Shutdown()
+
+	sc := NewDefaultConnection(t)
+	defer sc.Close()
+
+	msgCh := make(chan *stan.Msg, 10)
+	if _, err := sc.Subscribe("foo", func(m *stan.Msg) {
+		msgCh <- m
+	}); err != nil {
+		t.Fatalf("Unexpected error on subscribe: %v", err)
+	}
+	waitForNumSubs(t, s, clientName, 1)
+
+	mz := monitorMaintenancez(t, http.MethodGet, "", http.StatusOK)
+	if mz.ClusterID != s.ClusterID() {
+		t.Fatalf("Expected ClusterID to be %v, got %v", s.ClusterID(), mz.ClusterID)
+	}
+	if mz.DeliveryPaused || mz.PausedSince != nil {
+		t.Fatalf("Delivery should not be paused: %+v", mz)
+	}
+
+	monitorMaintenancez(t, http.MethodPost, "?pause=foo", http.StatusBadRequest)
+	monitorMaintenancez(t, http.MethodPut, "?pause=true", http.StatusMethodNotAllowed)
+
+	mz = monitorMaintenancez(t, http.MethodPost, "?pause=true", http.StatusOK)
+	defer s.ResumeDelivery()
+	if !mz.DeliveryPaused || mz.PausedSince == nil || mz.PausedSince.IsZero() {
+		t.Fatalf("Delivery should be paused: %+v", mz)
+	}
+	if !s.IsDeliveryPaused() {
+		t.Fatal("Delivery should be paused")
+	}
+	if err := sc.Publish("foo", []byte("msg")); err != nil {
+		t.Fatalf("Unexpected error on publish: %v", err)
+	}
+	select {
+	case m := <-msgCh:
+		t.Fatalf("Should not have received message while paused: %v", m)
+	case <-time.After(250 * time.Millisecond):
+	}
+
+	mz = monitorMaintenancez(t, http.MethodPost, "?pause=false", http.StatusOK)
+	if mz.DeliveryPaused || mz.PausedSince != nil {
+		t.Fatalf("Delivery should have been resumed: %+v", mz)
+	}
+	select {
+	case m := <-msgCh:
+		if m.Sequence != 1 {
+			t.Fatalf("Unexpected message: %v", m)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Did not get message stored while paused")
+	}
+}
+
 func TestMonitorServerzAfterRestart(t *testing.T) {
 	resetPreviousHTTPConnections()
 	cleanupDatastore(t)
@@ -168,6 +168,7 @@ var (
 	ErrNoChannel          = errors.New("stan: no configured channel")
 	ErrClusteredRestart   = errors.New("stan: cannot restart server in clustered mode if it was not previously clustered")
 	ErrChanDelInProgress  = errors.New("stan: channel is being deleted")
+	ErrPauseNotStandalone = errors.New("stan: delivery can be paused only in standalone mode")
 )
 
 // Shared regular expression to check clientID validity.
@@ -698,6 +699,15 @@ type StanServer struct {
 	// Will be created only when running in partitioning mode.
 	partitions *partitions
 
+	// Maintenance mode. When deliveryPaused is 1, published messages are
+	// still stored but nothing is delivered to subscriptions and ack
+	// timers are stopped. pausedAt is the time (in UnixNano) at which the
+	// server was paused. Both are accessed atomically. pauseMu serializes
+	// PauseDelivery/ResumeDelivery.
+	pausedAt       int64
+	deliveryPaused int32
+	pauseMu        sync.Mutex
+
 	// Use these flags for Debug/Trace in places where speed matters.
 	// Normally, Debugf and Tracef will check an internal variable to
 	// figure out if the statement should be logged, however, the
@@ -1119,6 +1129,11 @@ func (ss *subStore) Remove(c *channel, sub *subState, unsubscribe bool) {
 			if standaloneOrLeader {
 				// Set expiration in the past to force redelivery
 				expirationTime := time.Now().UnixNano() - int64(time.Second)
+				// In maintenance mode, set it before the pause so that
+				// ResumeDelivery does not push it back.
+				if s.isDeliveryPaused() {
+					expirationTime = atomic.LoadInt64(&s.pausedAt) - int64(time.Second)
+				}
 				// If there are pending messages in this sub, they need to be
 				// transferred to remaining queue subscribers.
 				numQSubs := len(qs.subs)
@@ -1291,6 +1306,7 @@ type Options struct {
 	EncryptionKey      []byte        // Encryption key. The environment NATS_STREAMING_ENCRYPTION_KEY takes precedence and is the preferred way to provide the key.
 	Clustering         ClusteringOptions
 	NATSClientOpts     []nats.Option
+	MaintenanceMonitor bool // Enables the monitoring endpoint used to pause/resume delivery (maintenance mode).
 }
 
 // Clone returns a deep copy of the Options object.
@@ -3367,6 +3383,11 @@ func makeSortedPendingMsgs(pendingMsgs map[uint64]int64) []*pendingMsg {
 
 // Redeliver all outstanding messages to a durable subscriber, used on resubscribe.
 func (s *StanServer) performDurableRedelivery(c *channel, sub *subState) {
+	// In maintenance mode, nothing would be sent. Keep newOnHold as is so
+	// that ResumeDelivery performs the redelivery before sending new messages.
+	if s.isDeliveryPaused() {
+		return
+	}
 	// Sort our messages outstanding from acksPending, grab some state and unlock.
 	sub.RLock()
 	sortedSeqs := makeSortedSequences(sub.acksPending)
@@ -3407,8 +3428,9 @@ func (s *StanServer) performDurableRedelivery(c *channel, sub *subState) {
 			sub.Unlock()
 		}
 	}
-	// Release newOnHold if needed.
-	if newOnHold {
+	// Release newOnHold if needed, unless the server has been paused
+	// in the meantime, in which case ResumeDelivery will do it.
+	if newOnHold && !s.isDeliveryPaused() {
 		sub.Lock()
 		sub.newOnHold = false
 		sub.Unlock()
@@ -3419,6 +3441,13 @@ func (s *StanServer) performDurableRedelivery(c *channel, sub *subState) {
 func (s *StanServer) performAckExpirationRedelivery(sub *subState, isStartup bool) {
 	// Sort our messages outstanding from acksPending, grab some state and unlock.
 	sub.Lock()
+	// In maintenance mode, ack timers are stopped. ResumeDelivery will
+	// restart them.
+	if s.isDeliveryPaused() {
+		sub.clearAckTimer()
+		sub.Unlock()
+		return
+	}
 	sortedPendingMsgs := makeSortedPendingMsgs(sub.acksPending)
 	if len(sortedPendingMsgs) == 0 {
 		sub.clearAckTimer()
@@ -3830,6 +3859,11 @@ func (s *StanServer) sendMsgToSub(sub *subState, m *pb.MsgProto, force bool) (bo
 		return false, false
 	}
 
+	// Nothing is sent, even if forced, while in maintenance mode.
+	if s.isDeliveryPaused() {
+		return false, false
+	}
+
 	// Don't send if we have too many outstanding already, unless forced to send.
 	ap := int32(len(sub.acksPending))
 	if !force && (ap >= sub.MaxInFlight) {
@@ -5142,7 +5176,7 @@ func (s *StanServer) processAck(c *channel, sub *subState, sequence uint64, from
 
 // Send any messages that are ready to be sent that have been queued to the group.
 func (s *StanServer) sendAvailableMessagesToQueue(c *channel, qs *queueState) {
-	if c == nil || qs == nil {
+	if c == nil || qs == nil || s.isDeliveryPaused() {
 		return
 	}
 
@@ -5171,6 +5205,9 @@ func (s *StanServer) sendAvailableMessagesToQueue(c *channel, qs *queueState) {
 
 // Send any messages that are ready to be sent that have been queued.
 func (s *StanServer) sendAvailableMessages(c *channel, sub *subState) {
+	if s.isDeliveryPaused() {
+		return
+	}
 	sub.Lock()
 	for nextSeq := sub.LastSent + 1; !sub.stalled; nextSeq++ {
 		nextMsg := s.getNextMsg(c, &nextSeq, &sub.LastSent)
@@ -5358,6 +5395,137 @@ func (s *StanServer) LastError() error {
 	return s.lastError
 }
 
+// isDeliveryPaused returns true if the server is in maintenance mode.
+func (s *StanServer) isDeliveryPaused() bool {
+	return atomic.LoadInt32(&s.deliveryPaused) == 1
+}
+
+// IsDeliveryPaused returns true if the server is in maintenance mode,
+// that is, PauseDelivery() has been called but not ResumeDelivery().
+func (s *StanServer) IsDeliveryPaused() bool {
+	return s.isDeliveryPaused()
+}
+
+// deliveryPausedSince returns the time at which the server entered
+// maintenance mode, and false if it is not in maintenance mode.
+func (s *StanServer) deliveryPausedSince() (time.Time, bool) {
+	if !s.isDeliveryPaused() {
+		return time.Time{}, false
+	}
+	pausedAt := atomic.LoadInt64(&s.pausedAt)
+	if pausedAt == 0 {
+		// Resumed in the meantime.
+		return time.Time{}, false
+	}
+	return time.Unix(0, pausedAt), true
+}
+
+// PauseDelivery puts the server in maintenance mode. Clients stay
+// connected and publishes are still accepted and stored, but no message
+// is delivered or redelivered to subscriptions and ack timers are stopped
+// until ResumeDelivery() is called.
+// Maintenance mode is not persisted, and since it would be lost on
+// leader election or FT failover, ErrPauseNotStandalone is returned
+// if the server does not run in standalone mode.
+func (s *StanServer) PauseDelivery() error {
+	if s.State() != Standalone {
+		return ErrPauseNotStandalone
+	}
+
+	s.pauseMu.Lock()
+	defer s.pauseMu.Unlock()
+
+	if s.isDeliveryPaused() {
+		return nil
+	}
+	atomic.StoreInt64(&s.pausedAt, time.Now().UnixNano())
+	atomic.StoreInt32(&s.deliveryPaused, 1)
+
+	// Any delivery or ack timer callback that grabs the sub's lock after
+	// this point will see the flag, so once timers have been cleared here,
+	// they won't be recreated.
+	for _, c := range s.channels.getAll() {
+		for _, sub := range c.ss.getAllSubs() {
+			sub.Lock()
+			sub.clearAckTimer()
+			sub.Unlock()
+		}
+	}
+	s.log.Noticef("Delivery paused, server is in maintenance mode")
+	return nil
+}
+
+// ResumeDelivery takes the server out of maintenance mode. The expiration
+// of messages pending acknowledgment is pushed back by the time spent in
+// maintenance mode, then ack timers are restarted and messages stored
+// during that time are delivered.
+func (s *StanServer) ResumeDelivery() {
+	type subToResume struct {
+		c         *channel
+		sub       *subState
+		newOnHold bool
+	}
+	var subs []subToResume
+
+	s.pauseMu.Lock()
+	if !s.isDeliveryPaused() {
+		s.pauseMu.Unlock()
+		return
+	}
+	pausedAt := atomic.LoadInt64(&s.pausedAt)
+	pausedFor := time.Now().UnixNano() - pausedAt
+
+	// Shift expiration times while still paused so that no new pending
+	// message is added in the meantime.
+	for _, c := range s.channels.getAll() {
+		for _, sub := range c.ss.getAllSubs() {
+			sub.Lock()
+			for seq, expire := range sub.acksPending {
+				// Only messages that had not expired yet when the server
+				// was paused are pushed back. This excludes messages that
+				// were transferred from a queue member that left during
+				// the pause, and 0 which means that expiration has not been
+				// set yet (this happens after a restart).
+				if expire > pausedAt {
+					sub.acksPending[seq] = expire + pausedFor
+				}
+			}
+			offline := sub.isOfflineDurableSubscriber()
+			newOnHold := sub.newOnHold
+			sub.Unlock()
+			if !offline {
+				subs = append(subs, subToResume{c: c, sub: sub, newOnHold: newOnHold})
+			}
+		}
+	}
+	atomic.StoreInt32(&s.deliveryPaused, 0)
+	atomic.StoreInt64(&s.pausedAt, 0)
+	s.pauseMu.Unlock()
+
+	s.log.Noticef("Delivery resumed after %v in maintenance mode", time.Duration(pausedFor))
+
+	queues := make(map[*queueState]*channel)
+	for _, rs := range subs {
+		if rs.newOnHold {
+			// Durable that resubscribed while paused: redeliver all its
+			// pending messages before new ones are sent.
+			s.performDurableRedelivery(rs.c, rs.sub)
+		} else {
+			// Redeliver what has expired and restart the ack timer.
+			s.performAckExpirationRedelivery(rs.sub, false)
+		}
+		// qstate is immutable, so no need for the sub's lock.
+		if qs := rs.sub.qstate; qs != nil {
+			queues[qs] = rs.c
+		} else {
+			s.sendAvailableMessages(rs.c, rs.sub)
+		}
+	}
+	for qs, c := range queues {
+		s.sendAvailableMessagesToQueue(c, qs)
+	}
+}
+
 // Shutdown will close our NATS connection and shutdown any embedded NATS server.
 func (s *StanServer) Shutdown() {
 	s.log.Noticef("Shutting down.")
@@ -382,3 +382,321 @@ func TestDeliveryRaceBetweenNextMsgAndStoring(t *testing.T) {
 		t.Fatal("Timeout!")
 	}
 }
+
+func pauseDelivery(t *testing.T, s *StanServer) {
+	if err := s.PauseDelivery(); err != nil {
+		stackFatalf(t, "Error pausing delivery: %v", err)
+	}
+	if !s.IsDeliveryPaused() {
+		stackFatalf(t, "Delivery should be paused")
+	}
+}
+
+func checkNoMsgWhilePaused(t *testing.T, msgCh chan *stan.Msg, waitFor time.Duration) {
+	select {
+	case m := <-msgCh:
+		stackFatalf(t, "Should not have received message while paused: %v", m)
+	case <-time.After(waitFor):
+	}
+}
+
+func TestPauseAndResumeDelivery(t *testing.T) {
+	s := runServer(t, clusterName)
+	defer s.Shutdown()
+
+	sc := NewDefaultConnection(t)
+	defer sc.Close()
+
+	msgCh := make(chan *stan.Msg, 10)
+	if _, err := sc.Subscribe("foo", func(m *stan.Msg) {
+		msgCh <- m
+	}, stan.SetManualAckMode(), stan.AckWait(time.Second)); err != nil {
+		t.Fatalf("Unexpected error on subscribe: %v", err)
+	}
+	waitForNumSubs(t, s, clientName, 1)
+
+	if err := sc.Publish("foo", []byte("msg1")); err != nil {
+		t.Fatalf("Unexpected error on publish: %v", err)
+	}
+	select {
+	case m := <-msgCh:
+		if m.Sequence != 1 || m.Redelivered {
+			t.Fatalf("Unexpected message: %v", m)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Did not get our message")
+	}
+
+	// Do not ack and pause delivery.
+	pauseDelivery(t, s)
+	sub := s.clients.getSubs(clientName)[0]
+	sub.RLock()
+	timerSet := sub.ackTimer != nil
+	sub.RUnlock()
+	if timerSet {
+		t.Fatal("Ack timer should have been stopped")
+	}
+
+	// Publishes are still accepted...
+	for i := 0; i < 2; i++ {
+		if err := sc.Publish("foo", []byte("msg")); err != nil {
+			t.Fatalf("Unexpected error on publish: %v", err)
+		}
+	}
+	// ...but nothing is delivered, and msg1 is not redelivered
+	// although its ack wait has elapsed.
+	checkNoMsgWhilePaused(t, msgCh, 1500*time.Millisecond)
+
+	s.ResumeDelivery()
+	resumed := time.Now()
+	if s.IsDeliveryPaused() {
+		t.Fatal("Delivery should have been resumed")
+	}
+
+	var gotNew int
+	for gotNew < 2 {
+		select {
+		case m := <-msgCh:
+			if m.Redelivered {
+				if time.Since(resumed) < 500*time.Millisecond {
+					t.Fatalf("Message redelivered too soon after resume: %v", m)
+				}
+				continue
+			}
+			if m.Sequence != uint64(gotNew+2) {
+				t.Fatalf("Unexpected message: %v", m)
+			}
+			m.Ack()
+			gotNew++
+		case <-time.After(time.Second):
+			t.Fatal("Did not get messages stored while paused")
+		}
+	}
+	// msg1 should now be redelivered, but only after the remaining
+	// of its ack wait.
+	select {
+	case m := <-msgCh:
+		if !m.Redelivered || m.Sequence != 1 {
+			t.Fatalf("Unexpected message: %v", m)
+		}
+		if time.Since(resumed) < 500*time.Millisecond {
+			t.Fatal("Message redelivered too soon after resume")
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("Message was not redelivered after resume")
+	}
+}
+
+func TestPauseAndResumeDeliveryQueueGroup(t *testing.T) {
+	s := runServer(t, clusterName)
+	defer s.Shutdown()
+
+	sc := NewDefaultConnection(t)
+	defer sc.Close()
+
+	msgCh := make(chan *stan.Msg, 100)
+	cb := func(m *stan.Msg) {
+		msgCh <- m
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := sc.QueueSubscribe("foo", "group", cb, stan.MaxInflight(5)); err != nil {
+			t.Fatalf("Unexpected error on subscribe: %v", err)
+		}
+	}
+	waitForNumSubs(t, s, clientName, 2)
+
+	pauseDelivery(t, s)
+
+	// Send more than what both members can have in flight.
+	toSend := 20
+	for i := 0; i < toSend; i++ {
+		if err := sc.Publish("foo", []byte("msg")); err != nil {
+			t.Fatalf("Unexpected error on publish: %v", err)
+		}
+	}
+	checkNoMsgWhilePaused(t, msgCh, 250*time.Millisecond)
+
+	s.ResumeDelivery()
+
+	received := make(map[uint64]struct{})
+	for len(received) < toSend {
+		select {
+		case m := <-msgCh:
+			if m.Redelivered {
+				t.Fatalf("Unexpected redelivered message: %v", m)
+			}
+			if _, dup := received[m.Sequence]; dup {
+				t.Fatalf("Duplicate message: %v", m)
+			}
+			received[m.Sequence] = struct{}{}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("Received only %v messages out of %v", len(received), toSend)
+		}
+	}
+}
+
+func TestPauseAndResumeDeliveryQueueMemberLeaves(t *testing.T) {
+	s := runServer(t, clusterName)
+	defer s.Shutdown()
+
+	sc1 := NewDefaultConnection(t)
+	defer sc1.Close()
+
+	msgCh1 := make(chan *stan.Msg, 10)
+	qsub1, err := sc1.QueueSubscribe("foo", "group", func(m *stan.Msg) {
+		msgCh1 <- m
+	}, stan.SetManualAckMode(), stan.AckWait(time.Second))
+	if err != nil {
+		t.Fatalf("Unexpected error on subscribe: %v", err)
+	}
+	if err := sc1.Publish("foo", []byte("msg")); err != nil {
+		t.Fatalf("Unexpected error on publish: %v", err)
+	}
+	select {
+	case <-msgCh1:
+	case <-time.After(time.Second):
+		t.Fatal("Did not get our message")
+	}
+
+	sc2, err := stan.Connect(clusterName, "otherClient")
+	if err != nil {
+		t.Fatalf("Expected to connect correctly, got err %v", err)
+	}
+	defer sc2.Close()
+	msgCh2 := make(chan *stan.Msg, 10)
+	if _, err := sc2.QueueSubscribe("foo", "group", func(m *stan.Msg) {
+		msgCh2 <- m
+	}, stan.SetManualAckMode(), stan.AckWait(time.Second)); err != nil {
+		t.Fatalf("Unexpected error on subscribe: %v", err)
+	}
+	waitForNumSubs(t, s, "otherClient", 1)
+
+	pauseDelivery(t, s)
+	// Have the member leave well after the pause, that is, more
+	// than the ack wait.
+	checkNoMsgWhilePaused(t, msgCh2, 2*time.Second)
+
+	// Unacknowledged message is transferred to the remaining member
+	// and scheduled for quick redelivery, which must not happen while
+	// paused.
+	if err := qsub1.Unsubscribe(); err != nil {
+		t.Fatalf("Unexpected error on unsubscribe: %v", err)
+	}
+	waitForNumSubs(t, s, clientName, 0)
+	checkNoMsgWhilePaused(t, msgCh2, 500*time.Millisecond)
+
+	s.ResumeDelivery()
+
+	// The transferred message should be redelivered right away, not
+	// after the time spent paused before the member left.
+	select {
+	case m := <-msgCh2:
+		if !m.Redelivered || m.Sequence != 1 {
+			t.Fatalf("Unexpected message: %v", m)
+		}
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("Message was not redelivered to remaining member")
+	}
+}
+
+func TestPauseAndResumeDeliveryDurableResubscribe(t *testing.T) {
+	s := runServer(t, clusterName)
+	defer s.Shutdown()
+
+	sc := NewDefaultConnection(t)
+	defer sc.Close()
+
+	msgCh := make(chan *stan.Msg, 10)
+	cb := func(m *stan.Msg) {
+		msgCh <- m
+	}
+	dur, err := sc.Subscribe("foo", cb, stan.DurableName("dur"),
+		stan.SetManualAckMode(), stan.AckWait(time.Second))
+	if err != nil {
+		t.Fatalf("Unexpected error on subscribe: %v", err)
+	}
+	if err := sc.Publish("foo", []byte("msg1")); err != nil {
+		t.Fatalf("Unexpected error on publish: %v", err)
+	}
+	select {
+	case <-msgCh:
+	case <-time.After(time.Second):
+		t.Fatal("Did not get our message")
+	}
+	// Close without acking so the message stays pending.
+	if err := dur.Close(); err != nil {
+		t.Fatalf("Unexpected error on close: %v", err)
+	}
+	waitForNumSubs(t, s, clientName, 0)
+
+	pauseDelivery(t, s)
+
+	if err := sc.Publish("foo", []byte("msg2")); err != nil {
+		t.Fatalf("Unexpected error on publish: %v", err)
+	}
+	// Resuming the durable would normally redeliver msg1 right away
+	// and then send msg2.
+	if _, err := sc.Subscribe("foo", cb, stan.DurableName("dur"),
+		stan.SetManualAckMode(), stan.AckWait(time.Second)); err != nil {
+		t.Fatalf("Unexpected error on subscribe: %v", err)
+	}
+	waitForNumSubs(t, s, clientName, 1)
+	checkNoMsgWhilePaused(t, msgCh, 500*time.Millisecond)
+
+	s.ResumeDelivery()
+
+	// Pending message must be redelivered first, then the new one.
+	for _, expected := range []struct {
+		seq         uint64
+		redelivered bool
+	}{{1, true}, {2, false}} {
+		select {
+		case m := <-msgCh:
+			if m.Sequence != expected.seq || m.Redelivered != expected.redelivered {
+				t.Fatalf("Expected seq=%v redelivered=%v, got %v", expected.seq, expected.redelivered, m)
+			}
+			m.Ack()
+		case <-time.After(time.Second):
+			t.Fatalf("Did not get seq %v after resume", expected.seq)
+		}
+	}
+}
+
+func TestPauseAndResumeDeliveryNewSubscription(t *testing.T) {
+	s := runServer(t, clusterName)
+	defer s.Shutdown()
+
+	sc := NewDefaultConnection(t)
+	defer sc.Close()
+
+	toSend := 3
+	for i := 0; i < toSend; i++ {
+		if err := sc.Publish("foo", []byte("msg")); err != nil {
+			t.Fatalf("Unexpected error on publish: %v", err)
+		}
+	}
+
+	pauseDelivery(t, s)
+
+	msgCh := make(chan *stan.Msg, 10)
+	if _, err := sc.Subscribe("foo", func(m *stan.Msg) {
+		msgCh <- m
+	}, stan.DeliverAllAvailable()); err != nil {
+		t.Fatalf("Unexpected error on subscribe: %v", err)
+	}
+	waitForNumSubs(t, s, clientName, 1)
+	checkNoMsgWhilePaused(t, msgCh, 250*time.Millisecond)
+
+	s.ResumeDelivery()
+
+	for i := 0; i < toSend; i++ {
+		select {
+		case m := <-msgCh:
+			if m.Sequence != uint64(i+1) {
+				t.Fatalf("Expected seq %v, got %v", i+1, m.Sequence)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Did not get messages stored while paused")
+		}
+	}
+}